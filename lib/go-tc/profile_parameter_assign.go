@@ -0,0 +1,62 @@
+package tc
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// ParameterKey identifies a Parameter by its natural key (Name, ConfigFile,
+// Value) rather than by its numeric ID. It's used by APIs that create
+// Parameters on demand when associating them with a Profile.
+type ParameterKey struct {
+	Name       string `json:"name"`
+	ConfigFile string `json:"configFile"`
+	Value      string `json:"value"`
+}
+
+// AssignParametersByNameOpts controls the behavior of a bulk
+// AssignParametersToProfileByName request.
+type AssignParametersByNameOpts struct {
+	// DryRun, when true, computes the create/associate/skip diff without
+	// making any changes. Since nothing is actually mutated, a Parameter
+	// that's already associated with the Profile is reported as Skipped in
+	// DryRun mode regardless of Idempotent - there's nothing to fail.
+	DryRun bool `json:"dryRun,omitempty"`
+	// Idempotent, when true, treats a Parameter that's already associated
+	// with the Profile as a no-op (reported as Skipped) instead of an error
+	// on a live (non-DryRun) run.
+	Idempotent bool `json:"idempotent,omitempty"`
+}
+
+// AssignParametersByNameReq is the request body for a bulk
+// AssignParametersToProfileByName call.
+type AssignParametersByNameReq struct {
+	Parameters []ParameterKey `json:"parameters"`
+	AssignParametersByNameOpts
+}
+
+// AssignParametersByNameResult reports what an
+// AssignParametersToProfileByName call did - or, in DryRun mode, would do -
+// for each requested ParameterKey.
+type AssignParametersByNameResult struct {
+	// Created lists Parameters that didn't already exist and were created.
+	Created []ParameterKey `json:"created"`
+	// Associated lists Parameters that existed but were not yet associated
+	// with the Profile, and were newly associated.
+	Associated []ParameterKey `json:"associated"`
+	// Skipped lists Parameters that were already associated with the
+	// Profile. Populated whenever DryRun is set (nothing is mutated, so a
+	// conflict is informational only) or, on a live run, when Idempotent is
+	// set. On a live run without Idempotent, a conflicting Parameter causes
+	// the whole request to fail instead of being reported here.
+	Skipped []ParameterKey `json:"skipped"`
+}
@@ -0,0 +1,37 @@
+package tc
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import "time"
+
+// ProfileParameterSnapshot is a point-in-time capture of every Parameter
+// associated with a Profile, analogous to a CDN snapshot but scoped to a
+// single Profile's Parameter associations.
+type ProfileParameterSnapshot struct {
+	ID         int                `json:"id"`
+	ProfileID  int                `json:"profileId"`
+	TakenAt    time.Time          `json:"takenAt"`
+	Parameters []ProfileParameter `json:"parameters"`
+}
+
+// ProfileParameterSnapshotDiff describes how two ProfileParameterSnapshots
+// of the same Profile differ.
+type ProfileParameterSnapshotDiff struct {
+	Added   []ProfileParameter `json:"added"`
+	Removed []ProfileParameter `json:"removed"`
+	// Changed is not populated by the diff endpoint: snapshots record only
+	// Parameter IDs, so an association is either added or removed.
+	Changed []ProfileParameter `json:"changed"`
+}
@@ -0,0 +1,51 @@
+package tc
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// ProfileParameterTemplate is a Parameter whose Value may contain `${var}`
+// placeholders, to be resolved from a variable scope at assignment time
+// rather than stored literally.
+type ProfileParameterTemplate struct {
+	Name       string `json:"name"`
+	ConfigFile string `json:"configFile"`
+	// Value is the template string, e.g. "STRING ${ats_user}".
+	Value string `json:"value"`
+}
+
+// ProfileParameterTemplateRequest is the POST body that
+// CreateProfileParameterFromTemplate sends to the profileparameters route
+// (the one CreateProfileParameter/CreateMultipleProfileParameters use). When
+// Template is true, the embedded ProfileParameterTemplate's Value is
+// treated as a template and materialized server-side using Variables
+// before being stored, rather than as a literal Parameter value.
+type ProfileParameterTemplateRequest struct {
+	ProfileID int `json:"profileId"`
+	ProfileParameterTemplate
+	// Template, when true, indicates that Value contains `${var}`
+	// placeholders to resolve against Variables rather than a literal value.
+	Template bool `json:"template,omitempty"`
+	// Variables supplies the values substituted into Value's placeholders.
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// ResolvedProfileParameter is a single entry in a
+// ResolveProfileParametersPreview response: the materialized Value a
+// template would produce, without persisting anything.
+type ResolvedProfileParameter struct {
+	Name          string `json:"name"`
+	ConfigFile    string `json:"configFile"`
+	TemplateValue string `json:"templateValue"`
+	ResolvedValue string `json:"resolvedValue"`
+}
@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -105,6 +107,44 @@ func TestProfileParameters(t *testing.T) {
 					},
 					Expectations: utils.CkRequest(utils.HasError(), utils.HasStatus(http.StatusBadRequest)),
 				},
+				"PRECONDITION FAILED when STALE ETAG on SINGLE PARAMETER": {
+					ClientSession: TOSession,
+					RequestHeaders: http.Header{
+						rfc.IfUnmodifiedSince: {currentTime.Format(time.RFC1123)},
+						rfc.IfMatch:           {`"stale-etag"`},
+					},
+					RequestBody: map[string]interface{}{
+						"profileId":   GetProfileID(t, "EDGE2")(),
+						"parameterId": GetParameterID(t, "health.threshold.queryTime", "rascal.properties", "1000")(),
+					},
+					Expectations: utils.CkRequest(utils.HasError(), utils.HasStatus(http.StatusPreconditionFailed)),
+				},
+				"PRECONDITION FAILED when STALE ETAG on MULTIPLE PARAMETERS": {
+					ClientSession: TOSession,
+					RequestHeaders: http.Header{
+						rfc.IfUnmodifiedSince: {currentTime.Format(time.RFC1123)},
+						rfc.IfMatch:           {`"stale-etag"`},
+					},
+					RequestBody: map[string]interface{}{
+						"profileParameters": []map[string]interface{}{
+							{
+								"profileId":   GetProfileID(t, "MID1")(),
+								"parameterId": GetParameterID(t, "CONFIG proxy.config.admin.user_id", "records.config", "STRING ats")(),
+							},
+						},
+					},
+					Expectations: utils.CkRequest(utils.HasError(), utils.HasStatus(http.StatusPreconditionFailed)),
+				},
+			},
+			"ASSIGN BY NAME": {
+				"OK when DRY RUN computes diff without mutating": {
+					ClientSession: TOSession,
+					Expectations:  utils.CkRequest(utils.NoError(), utils.HasStatus(http.StatusOK)),
+				},
+				"OK when ALREADY ASSOCIATED and IDEMPOTENT": {
+					ClientSession: TOSession,
+					Expectations:  utils.CkRequest(utils.NoError(), utils.HasStatus(http.StatusOK)),
+				},
 			},
 			"DELETE": {
 				"OK when VALID request": {
@@ -115,6 +155,64 @@ func TestProfileParameters(t *testing.T) {
 					},
 					Expectations: utils.CkRequest(utils.NoError(), utils.HasStatus(http.StatusOK)),
 				},
+				// Targets a different (profile, parameter) pair than "OK when
+				// VALID request" above - the precondition check must reject
+				// this regardless of subtest execution order, not depend on
+				// whichever DELETE case runs (and deletes the association) first.
+				"PRECONDITION FAILED when STALE ETAG": {
+					EndpointId:    GetProfileID(t, "EDGE1"),
+					ClientSession: TOSession,
+					RequestHeaders: http.Header{
+						rfc.IfUnmodifiedSince: {currentTime.Format(time.RFC1123)},
+						rfc.IfMatch:           {`"stale-etag"`},
+					},
+					RequestParams: url.Values{
+						"parameterId": {strconv.Itoa(GetParameterID(t, "health.threshold.availableBandwidthInKbps", "rascal.properties", ">1750000")())},
+					},
+					Expectations: utils.CkRequest(utils.HasError(), utils.HasStatus(http.StatusPreconditionFailed)),
+				},
+			},
+			"GENERATED CONFIGS": {
+				// Uses EDGE2 rather than MID1/MID2 so this subtest's
+				// (profile, parameter) association doesn't race the
+				// identical tuples created by POST's "OK when MULTIPLE
+				// PARAMETERS" and "PRECONDITION FAILED ... MULTIPLE
+				// PARAMETERS" cases - methodTests is a map, so subtests
+				// across method groups run in an unspecified order.
+				"OK when records.config REFLECTS ASSIGNED PARAMETERS": {
+					EndpointId:    GetProfileID(t, "EDGE2"),
+					ClientSession: TOSession,
+					RequestBody: map[string]interface{}{
+						"profileParameters": []map[string]interface{}{
+							{
+								"profileId":   GetProfileID(t, "EDGE2")(),
+								"parameterId": GetParameterID(t, "CONFIG proxy.config.admin.user_id", "records.config", "STRING ats")(),
+							},
+						},
+					},
+					Expectations: utils.CkRequest(utils.NoError(), utils.HasStatus(http.StatusOK)),
+				},
+			},
+			"SNAPSHOT": {
+				"OK when SNAPSHOT then MUTATE then RESTORE": {
+					EndpointId:    GetProfileID(t, "EDGE1"),
+					ClientSession: TOSession,
+					Expectations:  utils.CkRequest(utils.NoError(), utils.HasStatus(http.StatusOK)),
+				},
+			},
+			"TEMPLATE": {
+				"BAD REQUEST when UNRESOLVED VARIABLE": {
+					ClientSession: TOSession,
+					Expectations:  utils.CkRequest(utils.HasError(), utils.HasStatus(http.StatusBadRequest)),
+				},
+				"OK when SUBSTITUTION succeeds": {
+					ClientSession: TOSession,
+					Expectations:  utils.CkRequest(utils.NoError(), utils.HasStatus(http.StatusOK)),
+				},
+				"OK when RE-RENDER of the same template": {
+					ClientSession: TOSession,
+					Expectations:  utils.CkRequest(utils.NoError(), utils.HasStatus(http.StatusOK)),
+				},
 			},
 		}
 
@@ -156,12 +254,12 @@ func TestProfileParameters(t *testing.T) {
 					case "POST":
 						t.Run(name, func(t *testing.T) {
 							if len(profileParameters) == 0 {
-								alerts, reqInf, err := testCase.ClientSession.CreateProfileParameter(profileParameter)
+								alerts, reqInf, err := testCase.ClientSession.CreateProfileParameterWithHdr(profileParameter, testCase.RequestHeaders)
 								for _, check := range testCase.Expectations {
 									check(t, reqInf, nil, alerts, err)
 								}
 							} else {
-								alerts, reqInf, err := testCase.ClientSession.CreateMultipleProfileParameters(profileParameters)
+								alerts, reqInf, err := testCase.ClientSession.CreateMultipleProfileParametersWithHdr(profileParameters, testCase.RequestHeaders)
 								for _, check := range testCase.Expectations {
 									check(t, reqInf, nil, alerts, err)
 								}
@@ -170,11 +268,102 @@ func TestProfileParameters(t *testing.T) {
 					case "DELETE":
 						t.Run(name, func(t *testing.T) {
 							parameterId, _ := strconv.Atoi(testCase.RequestParams["parameterId"][0])
-							alerts, reqInf, err := testCase.ClientSession.DeleteParameterByProfileParameter(testCase.EndpointId(), parameterId)
+							alerts, reqInf, err := testCase.ClientSession.DeleteParameterByProfileParameterWithHdr(testCase.EndpointId(), parameterId, testCase.RequestHeaders)
 							for _, check := range testCase.Expectations {
 								check(t, reqInf, nil, alerts, err)
 							}
 						})
+					case "ASSIGN BY NAME":
+						t.Run(name, func(t *testing.T) {
+							opts := tc.AssignParametersByNameOpts{
+								DryRun:     name == "OK when DRY RUN computes diff without mutating",
+								Idempotent: name == "OK when ALREADY ASSOCIATED and IDEMPOTENT",
+							}
+							// Already associated with EDGE1 (see "BAD REQUEST when
+							// ALREADY EXISTS" above). DryRun succeeds regardless of
+							// Idempotent since nothing is mutated either way.
+							params := []tc.ParameterKey{
+								{Name: "health.threshold.availableBandwidthInKbps", ConfigFile: "rascal.properties", Value: ">1750000"},
+							}
+							_, reqInf, err := testCase.ClientSession.AssignParametersToProfileByName("EDGE1", params, opts)
+							for _, check := range testCase.Expectations {
+								check(t, reqInf, nil, tc.Alerts{}, err)
+							}
+						})
+					case "GENERATED CONFIGS":
+						t.Run(name, func(t *testing.T) {
+							_, _, err := testCase.ClientSession.CreateMultipleProfileParameters(profileParameters)
+							assert.NoError(t, err, "Could not assign Parameters ahead of generating configs: %v", err)
+
+							configs, reqInf, err := testCase.ClientSession.GetProfileGeneratedConfigs(testCase.EndpointId(), nil)
+							for _, check := range testCase.Expectations {
+								check(t, reqInf, nil, tc.Alerts{}, err)
+							}
+							assert.RequireNoError(t, err, "Could not get generated configs: %v", err)
+							if !strings.Contains(configs["records.config"], "CONFIG proxy.config.admin.user_id STRING ats") {
+								t.Errorf("Expected records.config to contain the assigned Parameter, got: %s", configs["records.config"])
+							}
+						})
+					case "SNAPSHOT":
+						t.Run(name, func(t *testing.T) {
+							profileID := testCase.EndpointId()
+
+							before, _, err := testCase.ClientSession.GetProfileParametersWithHdr(nil)
+							assert.NoError(t, err, "Could not get Profile Parameters before snapshot: %v", err)
+							beforeIDs := profileParameterIDs(before, profileID)
+
+							snapshot, _, err := testCase.ClientSession.SnapshotProfileParameters(profileID)
+							assert.NoError(t, err, "Could not snapshot Profile Parameters: %v", err)
+
+							newParameterID := GetParameterID(t, "health.threshold.queryTime", "rascal.properties", "1000")()
+							_, _, err = testCase.ClientSession.CreateProfileParameter(tc.ProfileParameter{ProfileID: profileID, ParameterID: newParameterID})
+							assert.NoError(t, err, "Could not mutate Profile Parameters ahead of restore: %v", err)
+
+							alerts, reqInf, err := testCase.ClientSession.RestoreProfileParameterSnapshot(snapshot.ID)
+							for _, check := range testCase.Expectations {
+								check(t, reqInf, nil, alerts, err)
+							}
+
+							after, _, err := testCase.ClientSession.GetProfileParametersWithHdr(nil)
+							assert.NoError(t, err, "Could not get Profile Parameters after restore: %v", err)
+							afterIDs := profileParameterIDs(after, profileID)
+							assert.Equal(t, fmt.Sprint(beforeIDs), fmt.Sprint(afterIDs), "Expected Profile %d's Parameter set to be byte-identical after restore: before %v, after %v", profileID, beforeIDs, afterIDs)
+						})
+					case "TEMPLATE":
+						t.Run(name, func(t *testing.T) {
+							// Value contains an actual `${var}` placeholder;
+							// the BAD REQUEST case omits the variable needed to
+							// resolve it rather than just passing an empty map.
+							variables := map[string]string{"ats_user": "ats"}
+							if name == "BAD REQUEST when UNRESOLVED VARIABLE" {
+								variables = map[string]string{"unrelated_var": "unused"}
+							}
+							templateReq := tc.ProfileParameterTemplateRequest{
+								ProfileID: GetProfileID(t, "EDGE1")(),
+								ProfileParameterTemplate: tc.ProfileParameterTemplate{
+									Name:       "CONFIG proxy.config.admin.user_id",
+									ConfigFile: "records.config",
+									Value:      "STRING ${ats_user}",
+								},
+								Template:  true,
+								Variables: variables,
+							}
+							alerts, reqInf, err := testCase.ClientSession.CreateProfileParameterFromTemplate(templateReq)
+							for _, check := range testCase.Expectations {
+								check(t, reqInf, nil, alerts, err)
+							}
+							if name == "OK when SUBSTITUTION succeeds" {
+								resolved, _, err := testCase.ClientSession.ResolveProfileParametersPreview(
+									[]tc.ProfileParameterTemplate{templateReq.ProfileParameterTemplate}, variables)
+								assert.NoError(t, err, "Could not resolve template preview: %v", err)
+								assert.RequireEqual(t, 1, len(resolved), "Expected exactly one resolved Parameter")
+								assert.Equal(t, "STRING ats", resolved[0].ResolvedValue, "Expected ${ats_user} to resolve to 'ats', not be stored as the literal placeholder")
+							}
+							if name == "OK when RE-RENDER of the same template" {
+								_, _, err = testCase.ClientSession.CreateProfileParameterFromTemplate(templateReq)
+								assert.NoError(t, err, "Expected re-render of the same template to succeed: %v", err)
+							}
+						})
 					}
 				}
 			})
@@ -182,28 +371,39 @@ func TestProfileParameters(t *testing.T) {
 	})
 }
 
+// profileParameterIDs returns the sorted ParameterIDs associated with
+// profileID within pps, so two snapshots of the same Profile's Parameter
+// set can be compared by value rather than just by count.
+func profileParameterIDs(pps []tc.ProfileParameter, profileID int) []int {
+	ids := make([]int, 0, len(pps))
+	for _, pp := range pps {
+		if pp.ProfileID == profileID {
+			ids = append(ids, pp.ParameterID)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
 func CreateTestProfileParameters(t *testing.T) {
 	for _, profile := range testData.Profiles {
-		profileID := GetProfileID(t, profile.Name)()
-
+		params := make([]tc.ParameterKey, 0, len(profile.Parameters))
 		for _, parameter := range profile.Parameters {
 			assert.RequireNotNil(t, parameter.Name, "Expected parameter name to not be nil.")
 			assert.RequireNotNil(t, parameter.Value, "Expected parameter value to not be nil.")
 			assert.RequireNotNil(t, parameter.ConfigFile, "Expected parameter configFile to not be nil.")
 
-			getParameter, _, err := TOSession.GetParameterByNameAndConfigFileAndValueWithHdr(*parameter.Name, *parameter.ConfigFile, *parameter.Value, nil)
-			assert.RequireNoError(t, err, "Could not get Parameter %s: %v", *parameter.Name, err)
-			if len(getParameter) == 0 {
-				alerts, _, err := TOSession.CreateParameter(tc.Parameter{Name: *parameter.Name, Value: *parameter.Value, ConfigFile: *parameter.ConfigFile})
-				assert.RequireNoError(t, err, "Could not create Parameter %s: %v - alerts: %+v", parameter.Name, err, alerts.Alerts)
-				getParameter, _, err = TOSession.GetParameterByNameAndConfigFileAndValueWithHdr(*parameter.Name, *parameter.ConfigFile, *parameter.Value, nil)
-				assert.RequireNoError(t, err, "Could not get Parameter %s: %v", *parameter.Name, err)
-				assert.RequireNotEqual(t, 0, len(getParameter), "Could not get parameter %s: not found", *parameter.Name)
-			}
-			profileParameter := tc.ProfileParameter{ProfileID: profileID, ParameterID: getParameter[0].ID}
-			alerts, _, err := TOSession.CreateProfileParameter(profileParameter)
-			assert.NoError(t, err, "Could not associate Parameter %s with Profile %s: %v - alerts: %+v", parameter.Name, profile.Name, err, alerts.Alerts)
+			params = append(params, tc.ParameterKey{Name: *parameter.Name, ConfigFile: *parameter.ConfigFile, Value: *parameter.Value})
+		}
+		if len(params) == 0 {
+			continue
 		}
+		// Idempotent so that a Parameter already associated with this
+		// Profile is reported as skipped instead of failing the whole
+		// assignment.
+		result, _, err := TOSession.AssignParametersToProfileByName(profile.Name, params, tc.AssignParametersByNameOpts{Idempotent: true})
+		assert.NoError(t, err, "Could not assign Parameters to Profile %s: %v", profile.Name, err)
+		assert.Equal(t, len(params), len(result.Created)+len(result.Associated)+len(result.Skipped), "Expected every requested Parameter for Profile %s to be created, associated, or skipped", profile.Name)
 	}
 }
 
@@ -0,0 +1,37 @@
+package toclientlib
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"io"
+	"net/http"
+)
+
+// getMultipart performs a GET against route and returns the raw,
+// still-open response body for a caller to decode as multipart (e.g.
+// multipart/mixed), along with the usual ReqInf. Unlike get, it doesn't
+// attempt to json.Unmarshal the body - the caller owns and must Close it.
+func (to *Session) getMultipart(route string, body *io.ReadCloser) (ReqInf, error) {
+	resp, remoteAddr, reqInf, err := to.request(http.MethodGet, route, nil, nil)
+	if reqInf.RespHeaders == nil && resp != nil {
+		reqInf.RespHeaders = resp.Header
+	}
+	if err != nil {
+		return reqInf, err
+	}
+	_ = remoteAddr
+	*body = resp.Body
+	return reqInf, nil
+}
@@ -0,0 +1,152 @@
+package profileparameter
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/apache/trafficcontrol/lib/go-tc"
+	"github.com/apache/trafficcontrol/traffic_ops/traffic_ops_golang/api"
+)
+
+// AssignByName is the handler for POST /profiles/name/{name}/parameters/assign.
+// It associates the Parameters given in the request body - each identified
+// by (Name, ConfigFile, Value) rather than by ID - with the named Profile,
+// in a single transaction, creating any Parameter that doesn't already
+// exist. With DryRun set, no row is inserted or updated; the response
+// describes what would have happened, and an already-associated Parameter
+// is reported as skipped rather than failing the request. On a live run,
+// an already-associated Parameter fails the request unless Idempotent is
+// set, in which case it's likewise reported as skipped.
+func AssignByName(w http.ResponseWriter, r *http.Request) {
+	inf, userErr, sysErr, errCode := api.NewInfo(r, []string{"name"}, nil)
+	if userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, nil, errCode, userErr, sysErr)
+		return
+	}
+	defer inf.Close()
+	tx := inf.Tx.Tx
+
+	var req tc.AssignParametersByNameReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.HandleErr(w, r, tx, http.StatusBadRequest, fmt.Errorf("decoding request body: %w", err), nil)
+		return
+	}
+	if userErr := validateAssignRequest(req); userErr != nil {
+		api.HandleErr(w, r, tx, http.StatusBadRequest, userErr, nil)
+		return
+	}
+
+	profileName := inf.Params["name"]
+	var profileID int
+	if err := tx.QueryRow(`SELECT id FROM profile WHERE name = $1`, profileName).Scan(&profileID); err != nil {
+		if err == sql.ErrNoRows {
+			api.HandleErr(w, r, tx, http.StatusNotFound, fmt.Errorf("no such Profile: %s", profileName), nil)
+			return
+		}
+		api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("querying profile '%s': %w", profileName, err))
+		return
+	}
+
+	result := tc.AssignParametersByNameResult{}
+	for _, p := range req.Parameters {
+		parameterID, created, err := findOrCreateParameter(tx, p, req.DryRun)
+		if err != nil {
+			api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("finding/creating parameter '%s': %w", p.Name, err))
+			return
+		}
+		if parameterID == 0 {
+			// DryRun and the Parameter doesn't exist yet - it would be created.
+			result.Created = append(result.Created, p)
+			continue
+		}
+
+		var alreadyAssociated bool
+		if err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM profile_parameter WHERE profile = $1 AND parameter = $2)`, profileID, parameterID).Scan(&alreadyAssociated); err != nil {
+			api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("checking association of parameter '%s': %w", p.Name, err))
+			return
+		}
+
+		switch {
+		case alreadyAssociated && !req.Idempotent && !req.DryRun:
+			api.HandleErr(w, r, tx, http.StatusBadRequest, fmt.Errorf("parameter '%s' is already associated with profile '%s'", p.Name, profileName), nil)
+			return
+		case alreadyAssociated:
+			result.Skipped = append(result.Skipped, p)
+		case req.DryRun:
+			result.Associated = append(result.Associated, p)
+		default:
+			if _, err := tx.Exec(`INSERT INTO profile_parameter (profile, parameter) VALUES ($1, $2)`, profileID, parameterID); err != nil {
+				api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("associating parameter '%s': %w", p.Name, err))
+				return
+			}
+			if created {
+				result.Created = append(result.Created, p)
+			} else {
+				result.Associated = append(result.Associated, p)
+			}
+		}
+	}
+
+	api.WriteResp(w, r, result)
+}
+
+// validateAssignRequest rejects a request with no Parameters to assign, or
+// with a ParameterKey missing one of its natural-key fields - which would
+// otherwise silently create a garbage parameter row with an empty name,
+// config file, or value.
+func validateAssignRequest(req tc.AssignParametersByNameReq) error {
+	if len(req.Parameters) == 0 {
+		return fmt.Errorf("parameters: at least one Parameter is required")
+	}
+	for i, p := range req.Parameters {
+		if p.Name == "" {
+			return fmt.Errorf("parameters[%d]: name is required", i)
+		}
+		if p.ConfigFile == "" {
+			return fmt.Errorf("parameters[%d]: configFile is required", i)
+		}
+		if p.Value == "" {
+			return fmt.Errorf("parameters[%d]: value is required", i)
+		}
+	}
+	return nil
+}
+
+// findOrCreateParameter looks up the Parameter identified by key's natural
+// key, creating it if it doesn't exist and dryRun is false. It returns a
+// parameterID of 0, with created false, when dryRun is true and the
+// Parameter doesn't exist - there's nothing to associate against yet.
+func findOrCreateParameter(tx *sql.Tx, key tc.ParameterKey, dryRun bool) (parameterID int, created bool, err error) {
+	err = tx.QueryRow(`SELECT id FROM parameter WHERE name = $1 AND config_file = $2 AND value = $3`, key.Name, key.ConfigFile, key.Value).Scan(&parameterID)
+	switch err {
+	case nil:
+		return parameterID, false, nil
+	case sql.ErrNoRows:
+		if dryRun {
+			return 0, false, nil
+		}
+		err = tx.QueryRow(`INSERT INTO parameter (name, config_file, value) VALUES ($1, $2, $3) RETURNING id`, key.Name, key.ConfigFile, key.Value).Scan(&parameterID)
+		if err != nil {
+			return 0, false, err
+		}
+		return parameterID, true, nil
+	default:
+		return 0, false, err
+	}
+}
@@ -0,0 +1,83 @@
+package profileparameter
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"sort"
+
+	"github.com/apache/trafficcontrol/traffic_ops/traffic_ops_golang/api"
+)
+
+// GetProfileGeneratedConfigs is the handler for GET
+// /profiles/{id}/configfiles/generated. It previews every ATS config file
+// derivable from the Parameters currently associated with the Profile,
+// rendering each Parameter as a "<name> <value>" line grouped under its
+// ConfigFile, and returns the result as a multipart/mixed response with one
+// part per config file.
+func GetProfileGeneratedConfigs(w http.ResponseWriter, r *http.Request) {
+	inf, userErr, sysErr, errCode := api.NewInfo(r, []string{"id"}, []string{"id"})
+	if userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, nil, errCode, userErr, sysErr)
+		return
+	}
+	defer inf.Close()
+	tx := inf.Tx.Tx
+	profileID := inf.IntParams["id"]
+
+	rows, err := tx.Query(
+		`SELECT p.config_file, p.name, p.value
+		 FROM parameter p JOIN profile_parameter pp ON pp.parameter = p.id
+		 WHERE pp.profile = $1
+		 ORDER BY p.config_file, p.name`,
+		profileID,
+	)
+	if err != nil {
+		api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("querying parameters for profile %d: %w", profileID, err))
+		return
+	}
+	defer rows.Close()
+
+	lines := map[string][]string{}
+	var configFiles []string
+	for rows.Next() {
+		var configFile, name, value string
+		if err := rows.Scan(&configFile, &name, &value); err != nil {
+			api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("scanning parameter row: %w", err))
+			return
+		}
+		if _, ok := lines[configFile]; !ok {
+			configFiles = append(configFiles, configFile)
+		}
+		lines[configFile] = append(lines[configFile], fmt.Sprintf("%s %s", name, value))
+	}
+	sort.Strings(configFiles)
+
+	mw := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", fmt.Sprintf("multipart/mixed; boundary=%s", mw.Boundary()))
+	w.WriteHeader(http.StatusOK)
+	for _, configFile := range configFiles {
+		part, err := mw.CreateFormFile("configFile", configFile)
+		if err != nil {
+			return
+		}
+		for _, line := range lines[configFile] {
+			fmt.Fprintln(part, line)
+		}
+	}
+	mw.Close()
+}
@@ -0,0 +1,209 @@
+package profileparameter
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/apache/trafficcontrol/lib/go-rfc"
+	"github.com/apache/trafficcontrol/lib/go-tc"
+	"github.com/apache/trafficcontrol/traffic_ops/traffic_ops_golang/api"
+)
+
+// profileParameterRequest is the union of the shapes accepted by the
+// ProfileParameter POST route: a single {profileId, parameterId}
+// association, a {profileParameters: [...]} bulk request (a bare JSON array
+// of associations is decoded into ProfileParameters as well), or a
+// tc.ProfileParameterTemplateRequest whose Value is materialized
+// server-side before being stored.
+type profileParameterRequest struct {
+	ProfileID         int                   `json:"profileId"`
+	ParameterID       int                   `json:"parameterId"`
+	ProfileParameters []tc.ProfileParameter `json:"profileParameters"`
+	tc.ProfileParameterTemplate
+	Template  bool              `json:"template,omitempty"`
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// Create is the handler for POST profileparameters (also reachable via the
+// CreateProfileParameter, CreateMultipleProfileParameters, and
+// CreateProfileParameterFromTemplate client calls, which post a single
+// association, a JSON array of associations, or a templated request to the
+// same route). It honors If-Unmodified-Since and If-Match for optimistic
+// concurrency, returning 412 Precondition Failed on a stale value. For a
+// templated request, an association that already exists is left in place
+// (its template lineage is still recorded) instead of being rejected.
+func Create(w http.ResponseWriter, r *http.Request) {
+	inf, userErr, sysErr, errCode := api.NewInfo(r, nil, nil)
+	if userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, nil, errCode, userErr, sysErr)
+		return
+	}
+	defer inf.Close()
+	tx := inf.Tx.Tx
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		api.HandleErr(w, r, tx, http.StatusBadRequest, fmt.Errorf("reading request body: %w", err), nil)
+		return
+	}
+	var req profileParameterRequest
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &req.ProfileParameters)
+	} else {
+		err = json.Unmarshal(body, &req)
+	}
+	if err != nil {
+		api.HandleErr(w, r, tx, http.StatusBadRequest, fmt.Errorf("decoding request body: %w", err), nil)
+		return
+	}
+
+	associations := req.ProfileParameters
+	if len(associations) == 0 {
+		if req.Template {
+			parameterID, err := materializeTemplateParameter(tx, req.ProfileParameterTemplate, req.Variables)
+			if err != nil {
+				api.HandleErr(w, r, tx, http.StatusBadRequest, err, nil)
+				return
+			}
+			associations = []tc.ProfileParameter{{ProfileID: req.ProfileID, ParameterID: parameterID}}
+		} else {
+			if req.ProfileID == 0 || req.ParameterID == 0 {
+				api.HandleErr(w, r, tx, http.StatusBadRequest, fmt.Errorf("profileId and parameterId are required"), nil)
+				return
+			}
+			associations = []tc.ProfileParameter{{ProfileID: req.ProfileID, ParameterID: req.ParameterID}}
+		}
+	}
+
+	if err := createAssociations(tx, r, associations, req.Template && len(req.ProfileParameters) == 0); err != nil {
+		api.HandleErr(w, r, tx, err.code, err.userErr, err.sysErr)
+		return
+	}
+
+	alerts := tc.CreateAlerts(tc.SuccessLevel, fmt.Sprintf("%d profile parameter association(s) were created", len(associations)))
+	api.WriteAlerts(w, r, http.StatusOK, alerts)
+}
+
+// Delete is the handler for DELETE profileparameters?profileId=&parameterId=.
+// It honors If-Unmodified-Since and If-Match for optimistic concurrency,
+// returning 412 Precondition Failed on a stale value.
+func Delete(w http.ResponseWriter, r *http.Request) {
+	inf, userErr, sysErr, errCode := api.NewInfo(r, nil, nil)
+	if userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, nil, errCode, userErr, sysErr)
+		return
+	}
+	defer inf.Close()
+	tx := inf.Tx.Tx
+
+	profileID, err := strconv.Atoi(inf.Params["profileId"])
+	if err != nil {
+		api.HandleErr(w, r, tx, http.StatusBadRequest, fmt.Errorf("profileId is required"), nil)
+		return
+	}
+	parameterID, err := strconv.Atoi(inf.Params["parameterId"])
+	if err != nil {
+		api.HandleErr(w, r, tx, http.StatusBadRequest, fmt.Errorf("parameterId is required"), nil)
+		return
+	}
+
+	if userErr, sysErr, code := checkPrecondition(tx, r, profileID); userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, tx, code, userErr, sysErr)
+		return
+	}
+
+	if _, err := tx.Exec(`DELETE FROM profile_parameter WHERE profile = $1 AND parameter = $2`, profileID, parameterID); err != nil {
+		api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("deleting association: %w", err))
+		return
+	}
+
+	alerts := tc.CreateAlerts(tc.SuccessLevel, "profile parameter association was deleted")
+	api.WriteAlerts(w, r, http.StatusOK, alerts)
+}
+
+// handlerError carries the three-valued (userErr, sysErr, code) shape the
+// rest of the package's handlers report through api.HandleErr, so a helper
+// like createAssociations can report a failure without writing to w itself.
+type handlerError struct {
+	userErr error
+	sysErr  error
+	code    int
+}
+
+// createAssociations inserts each of associations' (ProfileID, ParameterID)
+// pairs into profile_parameter, after checking the optimistic-concurrency
+// precondition for its Profile and that the pair isn't already associated.
+// With keepExisting set, an already-associated pair is skipped rather than
+// reported as an error.
+func createAssociations(tx *sql.Tx, r *http.Request, associations []tc.ProfileParameter, keepExisting bool) *handlerError {
+	for _, pp := range associations {
+		if userErr, sysErr, code := checkPrecondition(tx, r, pp.ProfileID); userErr != nil || sysErr != nil {
+			return &handlerError{userErr, sysErr, code}
+		}
+		var alreadyAssociated bool
+		if err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM profile_parameter WHERE profile = $1 AND parameter = $2)`, pp.ProfileID, pp.ParameterID).Scan(&alreadyAssociated); err != nil {
+			return &handlerError{nil, fmt.Errorf("checking existing association: %w", err), http.StatusInternalServerError}
+		}
+		if alreadyAssociated && keepExisting {
+			continue
+		}
+		if alreadyAssociated {
+			return &handlerError{fmt.Errorf("parameter %d is already associated with profile %d", pp.ParameterID, pp.ProfileID), nil, http.StatusBadRequest}
+		}
+		if _, err := tx.Exec(`INSERT INTO profile_parameter (profile, parameter) VALUES ($1, $2)`, pp.ProfileID, pp.ParameterID); err != nil {
+			return &handlerError{nil, fmt.Errorf("associating parameter %d with profile %d: %w", pp.ParameterID, pp.ProfileID, err), http.StatusInternalServerError}
+		}
+	}
+	return nil
+}
+
+// checkPrecondition compares the request's If-Match and If-Unmodified-Since
+// headers (if present) against profileID's last_updated timestamp, failing
+// the request with 412 on a mismatch or stale value. Absent headers impose
+// no precondition.
+func checkPrecondition(tx *sql.Tx, r *http.Request, profileID int) (userErr error, sysErr error, errCode int) {
+	ifMatch := r.Header.Get(rfc.IfMatch)
+	ifUnmodifiedSince := r.Header.Get(rfc.IfUnmodifiedSince)
+	if ifMatch == "" && ifUnmodifiedSince == "" {
+		return nil, nil, 0
+	}
+
+	var lastUpdated time.Time
+	if err := tx.QueryRow(`SELECT last_updated FROM profile WHERE id = $1`, profileID).Scan(&lastUpdated); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("no such profile: %d", profileID), nil, http.StatusNotFound
+		}
+		return nil, fmt.Errorf("querying profile %d last_updated: %w", profileID, err), http.StatusInternalServerError
+	}
+
+	if ifMatch != "" && ifMatch != fmt.Sprintf(`"%d"`, lastUpdated.UnixNano()) {
+		return fmt.Errorf("If-Match precondition failed for profile %d", profileID), nil, http.StatusPreconditionFailed
+	}
+	if ifUnmodifiedSince != "" {
+		since, err := time.Parse(time.RFC1123, ifUnmodifiedSince)
+		if err == nil && lastUpdated.After(since) {
+			return fmt.Errorf("If-Unmodified-Since precondition failed for profile %d", profileID), nil, http.StatusPreconditionFailed
+		}
+	}
+	return nil, nil, 0
+}
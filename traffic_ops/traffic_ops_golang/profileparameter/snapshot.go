@@ -0,0 +1,212 @@
+package profileparameter
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/apache/trafficcontrol/lib/go-tc"
+	"github.com/apache/trafficcontrol/traffic_ops/traffic_ops_golang/api"
+)
+
+// SnapshotProfileParameters is the handler for POST
+// /profiles/{id}/parameters/snapshots. It captures the Profile's current
+// Parameter associations into a new profile_parameter_snapshot row.
+func SnapshotProfileParameters(w http.ResponseWriter, r *http.Request) {
+	inf, userErr, sysErr, errCode := api.NewInfo(r, []string{"id"}, []string{"id"})
+	if userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, nil, errCode, userErr, sysErr)
+		return
+	}
+	defer inf.Close()
+	tx := inf.Tx.Tx
+	profileID := inf.IntParams["id"]
+
+	var snapshot tc.ProfileParameterSnapshot
+	if err := tx.QueryRow(`INSERT INTO profile_parameter_snapshot (profile) VALUES ($1) RETURNING id, profile, taken_at`, profileID).
+		Scan(&snapshot.ID, &snapshot.ProfileID, &snapshot.TakenAt); err != nil {
+		api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("creating snapshot for profile %d: %w", profileID, err))
+		return
+	}
+
+	if _, err := tx.Exec(
+		`INSERT INTO profile_parameter_snapshot_parameter (snapshot, parameter)
+		 SELECT $1, parameter FROM profile_parameter WHERE profile = $2`,
+		snapshot.ID, profileID,
+	); err != nil {
+		api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("capturing parameters for snapshot %d: %w", snapshot.ID, err))
+		return
+	}
+
+	snapshot.Parameters = currentProfileParameters(tx, profileID)
+	api.WriteResp(w, r, snapshot)
+}
+
+// GetProfileParameterSnapshots is the handler for GET
+// /profiles/{id}/parameters/snapshots. It lists every
+// ProfileParameterSnapshot taken of the Profile, most recent first.
+func GetProfileParameterSnapshots(w http.ResponseWriter, r *http.Request) {
+	inf, userErr, sysErr, errCode := api.NewInfo(r, []string{"id"}, []string{"id"})
+	if userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, nil, errCode, userErr, sysErr)
+		return
+	}
+	defer inf.Close()
+	tx := inf.Tx.Tx
+	profileID := inf.IntParams["id"]
+
+	rows, err := tx.Query(`SELECT id, profile, taken_at FROM profile_parameter_snapshot WHERE profile = $1 ORDER BY taken_at DESC`, profileID)
+	if err != nil {
+		api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("querying snapshots for profile %d: %w", profileID, err))
+		return
+	}
+	defer rows.Close()
+
+	snapshots := []tc.ProfileParameterSnapshot{}
+	for rows.Next() {
+		var s tc.ProfileParameterSnapshot
+		if err := rows.Scan(&s.ID, &s.ProfileID, &s.TakenAt); err != nil {
+			api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("scanning snapshot row: %w", err))
+			return
+		}
+		s.Parameters = snapshotParameters(tx, s.ID)
+		snapshots = append(snapshots, s)
+	}
+	api.WriteResp(w, r, snapshots)
+}
+
+// RestoreProfileParameterSnapshot is the handler for POST
+// /profileparametersnapshots/{id}/restore. It atomically restores the
+// snapshotted Profile's Parameter associations to exactly the set captured
+// by the snapshot, creating and deleting associations as needed.
+func RestoreProfileParameterSnapshot(w http.ResponseWriter, r *http.Request) {
+	inf, userErr, sysErr, errCode := api.NewInfo(r, []string{"id"}, []string{"id"})
+	if userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, nil, errCode, userErr, sysErr)
+		return
+	}
+	defer inf.Close()
+	tx := inf.Tx.Tx
+	snapshotID := inf.IntParams["id"]
+
+	var profileID int
+	if err := tx.QueryRow(`SELECT profile FROM profile_parameter_snapshot WHERE id = $1`, snapshotID).Scan(&profileID); err != nil {
+		if err == sql.ErrNoRows {
+			api.HandleErr(w, r, tx, http.StatusNotFound, fmt.Errorf("no such snapshot: %d", snapshotID), nil)
+			return
+		}
+		api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("querying snapshot %d: %w", snapshotID, err))
+		return
+	}
+
+	if _, err := tx.Exec(`DELETE FROM profile_parameter WHERE profile = $1`, profileID); err != nil {
+		api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("clearing current associations for profile %d: %w", profileID, err))
+		return
+	}
+	if _, err := tx.Exec(
+		`INSERT INTO profile_parameter (profile, parameter)
+		 SELECT $1, parameter FROM profile_parameter_snapshot_parameter WHERE snapshot = $2`,
+		profileID, snapshotID,
+	); err != nil {
+		api.HandleErr(w, r, tx, http.StatusInternalServerError, nil, fmt.Errorf("restoring associations from snapshot %d: %w", snapshotID, err))
+		return
+	}
+
+	alerts := tc.CreateAlerts(tc.SuccessLevel, fmt.Sprintf("profile %d's parameters were restored from snapshot %d", profileID, snapshotID))
+	api.WriteAlerts(w, r, http.StatusOK, alerts)
+}
+
+// DiffProfileParameterSnapshots is the handler for GET
+// /profileparametersnapshots/{idA}/diff/{idB}. It reports the Parameter
+// associations added and removed between two snapshots of the same Profile.
+// Snapshots record only Parameter IDs, so Changed is always left empty.
+func DiffProfileParameterSnapshots(w http.ResponseWriter, r *http.Request) {
+	inf, userErr, sysErr, errCode := api.NewInfo(r, []string{"idA", "idB"}, []string{"idA", "idB"})
+	if userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, nil, errCode, userErr, sysErr)
+		return
+	}
+	defer inf.Close()
+	tx := inf.Tx.Tx
+
+	a := snapshotParameters(tx, inf.IntParams["idA"])
+	b := snapshotParameters(tx, inf.IntParams["idB"])
+
+	aByParam := make(map[int]tc.ProfileParameter, len(a))
+	for _, pp := range a {
+		aByParam[pp.ParameterID] = pp
+	}
+	bByParam := make(map[int]tc.ProfileParameter, len(b))
+	for _, pp := range b {
+		bByParam[pp.ParameterID] = pp
+	}
+
+	diff := tc.ProfileParameterSnapshotDiff{}
+	for id, pp := range bByParam {
+		if _, ok := aByParam[id]; !ok {
+			diff.Added = append(diff.Added, pp)
+		}
+	}
+	for id, pp := range aByParam {
+		if _, ok := bByParam[id]; !ok {
+			diff.Removed = append(diff.Removed, pp)
+		}
+	}
+	api.WriteResp(w, r, diff)
+}
+
+// currentProfileParameters fetches the Parameters currently associated with
+// profileID, sorted by ParameterID for deterministic output.
+func currentProfileParameters(tx *sql.Tx, profileID int) []tc.ProfileParameter {
+	rows, err := tx.Query(`SELECT parameter FROM profile_parameter WHERE profile = $1`, profileID)
+	if err != nil {
+		return nil
+	}
+	defer rows.Close()
+	return scanProfileParameterIDs(rows, profileID)
+}
+
+// snapshotParameters fetches the Parameters captured by snapshotID, sorted
+// by ParameterID for deterministic output.
+func snapshotParameters(tx *sql.Tx, snapshotID int) []tc.ProfileParameter {
+	var profileID int
+	_ = tx.QueryRow(`SELECT profile FROM profile_parameter_snapshot WHERE id = $1`, snapshotID).Scan(&profileID)
+	rows, err := tx.Query(`SELECT parameter FROM profile_parameter_snapshot_parameter WHERE snapshot = $1`, snapshotID)
+	if err != nil {
+		return nil
+	}
+	defer rows.Close()
+	return scanProfileParameterIDs(rows, profileID)
+}
+
+func scanProfileParameterIDs(rows *sql.Rows, profileID int) []tc.ProfileParameter {
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	pps := make([]tc.ProfileParameter, 0, len(ids))
+	for _, id := range ids {
+		pps = append(pps, tc.ProfileParameter{ProfileID: profileID, ParameterID: id})
+	}
+	return pps
+}
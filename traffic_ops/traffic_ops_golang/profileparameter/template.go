@@ -0,0 +1,127 @@
+package profileparameter
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/apache/trafficcontrol/lib/go-tc"
+	"github.com/apache/trafficcontrol/traffic_ops/traffic_ops_golang/api"
+)
+
+// templateVarPattern matches a `${var}` placeholder in a template Value.
+var templateVarPattern = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// resolveProfileParametersPreviewRequest is the POST body accepted by
+// ResolveProfileParametersPreview.
+type resolveProfileParametersPreviewRequest struct {
+	Templates []tc.ProfileParameterTemplate `json:"templates"`
+	Variables map[string]string             `json:"variables"`
+}
+
+// ResolveProfileParametersPreview is the handler for POST
+// profileparameters/preview. It materializes the Value each of the given
+// ProfileParameterTemplates would produce against Variables, without
+// creating or associating anything - a dry run for inspecting what a
+// templated CreateProfileParameterFromTemplate call would store.
+func ResolveProfileParametersPreview(w http.ResponseWriter, r *http.Request) {
+	inf, userErr, sysErr, errCode := api.NewInfo(r, nil, nil)
+	if userErr != nil || sysErr != nil {
+		api.HandleErr(w, r, nil, errCode, userErr, sysErr)
+		return
+	}
+	defer inf.Close()
+	tx := inf.Tx.Tx
+
+	var req resolveProfileParametersPreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.HandleErr(w, r, tx, http.StatusBadRequest, fmt.Errorf("decoding request body: %w", err), nil)
+		return
+	}
+
+	resolved := make([]tc.ResolvedProfileParameter, 0, len(req.Templates))
+	for _, t := range req.Templates {
+		value, err := materializeTemplate(t.Name, t.Value, req.Variables)
+		if err != nil {
+			api.HandleErr(w, r, tx, http.StatusBadRequest, err, nil)
+			return
+		}
+		resolved = append(resolved, tc.ResolvedProfileParameter{
+			Name:          t.Name,
+			ConfigFile:    t.ConfigFile,
+			TemplateValue: t.Value,
+			ResolvedValue: value,
+		})
+	}
+
+	api.WriteResp(w, r, resolved)
+}
+
+// materializeTemplateParameter resolves t's Value against variables,
+// finds-or-creates the resulting Parameter, and records the template
+// lineage against it so a later edit to a variable can re-materialize it.
+func materializeTemplateParameter(tx *sql.Tx, t tc.ProfileParameterTemplate, variables map[string]string) (parameterID int, err error) {
+	value, err := materializeTemplate(t.Name, t.Value, variables)
+	if err != nil {
+		return 0, err
+	}
+	parameterID, _, err = findOrCreateParameter(tx, tc.ParameterKey{Name: t.Name, ConfigFile: t.ConfigFile, Value: value}, false)
+	if err != nil {
+		return 0, fmt.Errorf("materializing template parameter '%s': %w", t.Name, err)
+	}
+	if err := recordTemplateLineage(tx, parameterID, t.Value, variables); err != nil {
+		return 0, fmt.Errorf("recording template lineage for '%s': %w", t.Name, err)
+	}
+	return parameterID, nil
+}
+
+// materializeTemplate resolves every `${var}` placeholder in value against
+// variables, failing with a descriptive error if any placeholder has no
+// corresponding variable.
+func materializeTemplate(name, value string, variables map[string]string) (string, error) {
+	var missing error
+	resolved := templateVarPattern.ReplaceAllStringFunc(value, func(match string) string {
+		varName := templateVarPattern.FindStringSubmatch(match)[1]
+		v, ok := variables[varName]
+		if !ok {
+			missing = fmt.Errorf("parameter '%s': unresolved template variable '%s'", name, varName)
+			return match
+		}
+		return v
+	})
+	if missing != nil {
+		return "", missing
+	}
+	return resolved, nil
+}
+
+// recordTemplateLineage stores the template string and the variables used
+// to resolve it against the materialized Parameter row, so that a later
+// edit to a variable can trigger re-materialization.
+func recordTemplateLineage(tx *sql.Tx, parameterID int, templateValue string, variables map[string]string) error {
+	variablesJSON, err := json.Marshal(variables)
+	if err != nil {
+		return fmt.Errorf("marshaling template variables: %w", err)
+	}
+	_, err = tx.Exec(
+		`UPDATE parameter SET template_value = $1, template_variables = $2 WHERE id = $3`,
+		templateValue, variablesJSON, parameterID,
+	)
+	return err
+}
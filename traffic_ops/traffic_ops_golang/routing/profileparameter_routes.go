@@ -0,0 +1,105 @@
+package routing
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"net/http"
+
+	"github.com/apache/trafficcontrol/traffic_ops/traffic_ops_golang/api"
+	"github.com/apache/trafficcontrol/traffic_ops/traffic_ops_golang/auth"
+	"github.com/apache/trafficcontrol/traffic_ops/traffic_ops_golang/profileparameter"
+)
+
+// profileParameterRoutes returns the v3 routes added for the profile
+// parameter subsystem (by-name assignment, snapshot/restore/diff,
+// generated-config preview, conditional create/delete of individual
+// associations, and template resolution). It's appended to the Route slice
+// built by Routes() alongside the rest of the v3 API.
+func profileParameterRoutes() []api.Route {
+	return []api.Route{
+		{
+			Version:            api.Version{Major: 3},
+			Method:             http.MethodPost,
+			Path:               "profiles/name/{name}/parameters/assign",
+			Handler:            profileparameter.AssignByName,
+			RequiredPrivLevel:  auth.PrivLevelOperations,
+			RequiredCapability: "PARAMETER:UPDATE",
+		},
+		{
+			Version:            api.Version{Major: 3},
+			Method:             http.MethodPost,
+			Path:               "profiles/{id}/parameters/snapshots",
+			Handler:            profileparameter.SnapshotProfileParameters,
+			RequiredPrivLevel:  auth.PrivLevelOperations,
+			RequiredCapability: "PARAMETER:UPDATE",
+		},
+		{
+			Version:            api.Version{Major: 3},
+			Method:             http.MethodGet,
+			Path:               "profiles/{id}/parameters/snapshots",
+			Handler:            profileparameter.GetProfileParameterSnapshots,
+			RequiredPrivLevel:  auth.PrivLevelReadOnly,
+			RequiredCapability: "PARAMETER:READ",
+		},
+		{
+			Version:            api.Version{Major: 3},
+			Method:             http.MethodPost,
+			Path:               "profileparametersnapshots/{id}/restore",
+			Handler:            profileparameter.RestoreProfileParameterSnapshot,
+			RequiredPrivLevel:  auth.PrivLevelOperations,
+			RequiredCapability: "PARAMETER:UPDATE",
+		},
+		{
+			Version:            api.Version{Major: 3},
+			Method:             http.MethodGet,
+			Path:               "profileparametersnapshots/{idA}/diff/{idB}",
+			Handler:            profileparameter.DiffProfileParameterSnapshots,
+			RequiredPrivLevel:  auth.PrivLevelReadOnly,
+			RequiredCapability: "PARAMETER:READ",
+		},
+		{
+			Version:            api.Version{Major: 3},
+			Method:             http.MethodGet,
+			Path:               "profiles/{id}/configfiles/generated",
+			Handler:            profileparameter.GetProfileGeneratedConfigs,
+			RequiredPrivLevel:  auth.PrivLevelReadOnly,
+			RequiredCapability: "PARAMETER:READ",
+		},
+		{
+			Version:            api.Version{Major: 3},
+			Method:             http.MethodPost,
+			Path:               "profileparameters",
+			Handler:            profileparameter.Create,
+			RequiredPrivLevel:  auth.PrivLevelOperations,
+			RequiredCapability: "PARAMETER:UPDATE",
+		},
+		{
+			Version:            api.Version{Major: 3},
+			Method:             http.MethodDelete,
+			Path:               "profileparameters",
+			Handler:            profileparameter.Delete,
+			RequiredPrivLevel:  auth.PrivLevelOperations,
+			RequiredCapability: "PARAMETER:UPDATE",
+		},
+		{
+			Version:            api.Version{Major: 3},
+			Method:             http.MethodPost,
+			Path:               "profileparameters/preview",
+			Handler:            profileparameter.ResolveProfileParametersPreview,
+			RequiredPrivLevel:  auth.PrivLevelReadOnly,
+			RequiredCapability: "PARAMETER:READ",
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package client
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/url"
+
+	"github.com/apache/trafficcontrol/traffic_ops/toclientlib"
+)
+
+// apiProfileGeneratedConfigs is the route for GetProfileGeneratedConfigs,
+// templated on the Profile's ID.
+const apiProfileGeneratedConfigs = apiBase + "/profiles/%d/configfiles/generated"
+
+// GetProfileGeneratedConfigs previews every ATS config file
+// (records.config, set_dscp_*.config, rascal.properties, etc.) derivable
+// from the Parameters currently associated with the Profile identified by
+// profileID, without requiring a cache-side ORT run. The response is
+// returned as a map of file name to file content, having been decoded from
+// the underlying multipart/mixed payload.
+func (to *Session) GetProfileGeneratedConfigs(profileID int, opts *url.Values) (map[string]string, toclientlib.ReqInf, error) {
+	route := fmt.Sprintf(apiProfileGeneratedConfigs, profileID)
+	if opts != nil {
+		route += "?" + opts.Encode()
+	}
+
+	var body io.ReadCloser
+	reqInf, err := to.getMultipart(route, &body)
+	if err != nil {
+		return nil, reqInf, err
+	}
+	defer body.Close()
+
+	_, params, err := mime.ParseMediaType(reqInf.RespHeaders.Get("Content-Type"))
+	if err != nil {
+		return nil, reqInf, fmt.Errorf("parsing multipart/mixed Content-Type: %w", err)
+	}
+
+	files := make(map[string]string)
+	reader := multipart.NewReader(body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, reqInf, fmt.Errorf("reading config file part: %w", err)
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return nil, reqInf, fmt.Errorf("reading config file part %s: %w", part.FileName(), err)
+		}
+		files[part.FileName()] = string(content)
+	}
+	return files, reqInf, nil
+}
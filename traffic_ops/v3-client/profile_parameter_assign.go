@@ -0,0 +1,47 @@
+package client
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/apache/trafficcontrol/lib/go-tc"
+	"github.com/apache/trafficcontrol/traffic_ops/toclientlib"
+)
+
+// apiProfileParametersAssignByName is the route for
+// AssignParametersToProfileByName, templated on the Profile's name.
+const apiProfileParametersAssignByName = apiBase + "/profiles/name/%s/parameters/assign"
+
+// AssignParametersToProfileByName associates the given Parameters - each
+// identified by (Name, ConfigFile, Value) rather than by ID - with the
+// Profile named profileName, in a single transaction. Any Parameter that
+// doesn't already exist is created. With opts.DryRun set, no changes are
+// made and a Parameter already associated with the Profile is reported as
+// Skipped rather than failing the request, regardless of opts.Idempotent -
+// there's nothing to conflict with when nothing is mutated. On a live run,
+// opts.Idempotent likewise reports an already-associated Parameter as
+// Skipped instead of causing the request to fail.
+func (to *Session) AssignParametersToProfileByName(profileName string, params []tc.ParameterKey, opts tc.AssignParametersByNameOpts) (tc.AssignParametersByNameResult, toclientlib.ReqInf, error) {
+	var data tc.AssignParametersByNameResult
+	reqBody := tc.AssignParametersByNameReq{
+		Parameters:                 params,
+		AssignParametersByNameOpts: opts,
+	}
+	route := fmt.Sprintf(apiProfileParametersAssignByName, url.PathEscape(profileName))
+	reqInf, err := to.post(route, reqBody, nil, &data)
+	return data, reqInf, err
+}
@@ -0,0 +1,55 @@
+package client
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/apache/trafficcontrol/lib/go-tc"
+	"github.com/apache/trafficcontrol/traffic_ops/toclientlib"
+)
+
+// CreateProfileParameterWithHdr behaves like CreateProfileParameter, but
+// forwards header, allowing a caller to set If-Unmodified-Since and/or
+// If-Match so the association is only created if the Profile Parameter set
+// hasn't changed underneath it. The server responds 412 Precondition
+// Failed on a stale ETag or timestamp.
+func (to *Session) CreateProfileParameterWithHdr(pp tc.ProfileParameter, header http.Header) (tc.Alerts, toclientlib.ReqInf, error) {
+	var alerts tc.Alerts
+	reqInf, err := to.post(apiProfileParameters, pp, header, &alerts)
+	return alerts, reqInf, err
+}
+
+// CreateMultipleProfileParametersWithHdr behaves like
+// CreateMultipleProfileParameters, but forwards header, allowing a caller
+// to set If-Unmodified-Since and/or If-Match for optimistic concurrency
+// around a bulk association.
+func (to *Session) CreateMultipleProfileParametersWithHdr(pps []tc.ProfileParameter, header http.Header) (tc.Alerts, toclientlib.ReqInf, error) {
+	var alerts tc.Alerts
+	reqInf, err := to.post(apiProfileParameters, pps, header, &alerts)
+	return alerts, reqInf, err
+}
+
+// DeleteParameterByProfileParameterWithHdr behaves like
+// DeleteParameterByProfileParameter, but forwards header, allowing a
+// caller to set If-Unmodified-Since and/or If-Match for optimistic
+// concurrency around the deletion.
+func (to *Session) DeleteParameterByProfileParameterWithHdr(profileID, parameterID int, header http.Header) (tc.Alerts, toclientlib.ReqInf, error) {
+	var alerts tc.Alerts
+	route := fmt.Sprintf(apiProfileParameters+"?profileId=%d&parameterId=%d", profileID, parameterID)
+	reqInf, err := to.del(route, header, &alerts)
+	return alerts, reqInf, err
+}
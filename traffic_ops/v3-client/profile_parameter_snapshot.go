@@ -0,0 +1,73 @@
+package client
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"fmt"
+
+	"github.com/apache/trafficcontrol/lib/go-tc"
+	"github.com/apache/trafficcontrol/traffic_ops/toclientlib"
+)
+
+// apiProfileParameterSnapshots is the route for SnapshotProfileParameters
+// and GetProfileParameterSnapshots, templated on the Profile's ID.
+const apiProfileParameterSnapshots = apiBase + "/profiles/%d/parameters/snapshots"
+
+// apiProfileParameterSnapshotRestore is the route for
+// RestoreProfileParameterSnapshot, templated on the snapshot's ID.
+const apiProfileParameterSnapshotRestore = apiBase + "/profileparametersnapshots/%d/restore"
+
+// apiProfileParameterSnapshotDiff is the route for
+// DiffProfileParameterSnapshots, templated on the two snapshot IDs being
+// compared.
+const apiProfileParameterSnapshotDiff = apiBase + "/profileparametersnapshots/%d/diff/%d"
+
+// SnapshotProfileParameters captures the current Parameter associations of
+// the Profile identified by profileID.
+func (to *Session) SnapshotProfileParameters(profileID int) (tc.ProfileParameterSnapshot, toclientlib.ReqInf, error) {
+	var snapshot tc.ProfileParameterSnapshot
+	route := fmt.Sprintf(apiProfileParameterSnapshots, profileID)
+	reqInf, err := to.post(route, nil, nil, &snapshot)
+	return snapshot, reqInf, err
+}
+
+// GetProfileParameterSnapshots lists every ProfileParameterSnapshot taken
+// for the Profile identified by profileID, most recent first.
+func (to *Session) GetProfileParameterSnapshots(profileID int) ([]tc.ProfileParameterSnapshot, toclientlib.ReqInf, error) {
+	var snapshots []tc.ProfileParameterSnapshot
+	route := fmt.Sprintf(apiProfileParameterSnapshots, profileID)
+	reqInf, err := to.get(route, nil, &snapshots)
+	return snapshots, reqInf, err
+}
+
+// RestoreProfileParameterSnapshot atomically restores a Profile's Parameter
+// associations to the state captured by the snapshot identified by
+// snapshotID, creating and deleting associations as needed.
+func (to *Session) RestoreProfileParameterSnapshot(snapshotID int) (tc.Alerts, toclientlib.ReqInf, error) {
+	var alerts tc.Alerts
+	route := fmt.Sprintf(apiProfileParameterSnapshotRestore, snapshotID)
+	reqInf, err := to.post(route, nil, nil, &alerts)
+	return alerts, reqInf, err
+}
+
+// DiffProfileParameterSnapshots reports the Parameter associations added
+// and removed between two ProfileParameterSnapshots of the same Profile. The
+// returned diff's Changed is always empty.
+func (to *Session) DiffProfileParameterSnapshots(a, b int) (tc.ProfileParameterSnapshotDiff, toclientlib.ReqInf, error) {
+	var diff tc.ProfileParameterSnapshotDiff
+	route := fmt.Sprintf(apiProfileParameterSnapshotDiff, a, b)
+	reqInf, err := to.get(route, nil, &diff)
+	return diff, reqInf, err
+}
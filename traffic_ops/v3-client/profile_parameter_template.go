@@ -0,0 +1,49 @@
+package client
+
+/*
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"github.com/apache/trafficcontrol/lib/go-tc"
+	"github.com/apache/trafficcontrol/traffic_ops/toclientlib"
+)
+
+// apiProfileParametersPreview is the route for
+// ResolveProfileParametersPreview.
+const apiProfileParametersPreview = apiBase + "/profileparameters/preview"
+
+// CreateProfileParameterFromTemplate creates (or finds) and associates a
+// Parameter whose Value is materialized from req's template and Variables,
+// recording the template lineage so that a later edit to a variable can
+// re-materialize it. req.Template must be true: otherwise the server treats
+// the body as a plain association, which requires a parameterId that
+// req does not carry, and rejects it.
+func (to *Session) CreateProfileParameterFromTemplate(req tc.ProfileParameterTemplateRequest) (tc.Alerts, toclientlib.ReqInf, error) {
+	var alerts tc.Alerts
+	reqInf, err := to.post(apiProfileParameters, req, nil, &alerts)
+	return alerts, reqInf, err
+}
+
+// ResolveProfileParametersPreview materializes the Values that would result
+// from applying variables to the given ProfileParameterTemplates, without
+// creating or associating anything.
+func (to *Session) ResolveProfileParametersPreview(templates []tc.ProfileParameterTemplate, variables map[string]string) ([]tc.ResolvedProfileParameter, toclientlib.ReqInf, error) {
+	var resolved []tc.ResolvedProfileParameter
+	reqBody := struct {
+		Templates []tc.ProfileParameterTemplate `json:"templates"`
+		Variables map[string]string             `json:"variables"`
+	}{Templates: templates, Variables: variables}
+	reqInf, err := to.post(apiProfileParametersPreview, reqBody, nil, &resolved)
+	return resolved, reqInf, err
+}